@@ -0,0 +1,141 @@
+package logos
+
+import (
+  "math"
+  "sort"
+)
+
+/*
+Corpus holds a collection of Publications and precomputes the word
+counts needed to score how distinctively a word appears within a given
+publication relative to the corpus as a whole (TF-IDF), turning the
+per-publication measures into a real cross-article ranking signal.
+*/
+type Corpus struct {
+  Publications []Publication
+  termCounts []map[string]int
+  documentFrequency map[string]int
+}
+
+/*
+CreateCorpus drains each publication's PublicationBody exactly once,
+recording per-publication word counts and how many publications each
+word appears in at all.
+*/
+func CreateCorpus(pubs []Publication) (Corpus) {
+  termCounts := make([]map[string]int, len(pubs))
+  documentFrequency := make(map[string]int)
+
+  for i, pub := range pubs {
+    counts := make(map[string]int)
+
+    for (pub.Text.HasNextWord()) {
+      w := pub.Text.NextWord()
+      counts[w]++
+    }
+
+    termCounts[i] = counts
+    for word := range counts {
+      documentFrequency[word]++
+    }
+  }
+
+  return Corpus{
+    Publications: pubs,
+    termCounts: termCounts,
+    documentFrequency: documentFrequency,
+  }
+}
+
+/*
+indexOf finds pub's position within the corpus, matching by the identity
+of its Text. Both CreateStringPubBody and CreateReaderPubBody return a
+pointer-valued PublicationBody, so the interface value is comparable
+with == and this can't conflate two distinct publications the way
+matching on Author and Score could.
+*/
+func (c Corpus) indexOf(pub Publication) (int, bool) {
+  for i, p := range c.Publications {
+    if (p.Text == pub.Text) {
+      return i, true
+    }
+  }
+  return 0, false
+}
+
+/*
+TermFrequency returns how often word occurs in pub, relative to pub's
+total word count.
+*/
+func (c Corpus) TermFrequency(pub Publication, word string) (float64) {
+  i, ok := c.indexOf(pub)
+  if (!ok) {
+    return 0.0
+  }
+
+  total := 0
+  for _, count := range c.termCounts[i] {
+    total += count
+  }
+
+  if (total == 0) {
+    return 0.0
+  }
+
+  return float64(c.termCounts[i][word]) / float64(total)
+}
+
+/*
+InverseDocumentFrequency scores how rare word is across the corpus: a
+word that shows up in every publication scores near zero, while a word
+unique to a single publication scores highest.
+*/
+func (c Corpus) InverseDocumentFrequency(word string) (float64) {
+  df := c.documentFrequency[word]
+  if (df == 0) {
+    return 0.0
+  }
+
+  return math.Log(float64(len(c.Publications)) / float64(df))
+}
+
+/*
+TFIDF scores every word that appears in pub by TermFrequency(pub, word)
+times InverseDocumentFrequency(word).
+*/
+func (c Corpus) TFIDF(pub Publication) (map[string]float64) {
+  i, ok := c.indexOf(pub)
+  if (!ok) {
+    return map[string]float64{}
+  }
+
+  scores := make(map[string]float64, len(c.termCounts[i]))
+  for word := range c.termCounts[i] {
+    scores[word] = c.TermFrequency(pub, word) * c.InverseDocumentFrequency(word)
+  }
+
+  return scores
+}
+
+/*
+KeyTerms returns the n words in pub with the highest TF-IDF score,
+ordered from most to least distinctive.
+*/
+func (c Corpus) KeyTerms(pub Publication, n int) ([]string) {
+  scores := c.TFIDF(pub)
+
+  words := make([]string, 0, len(scores))
+  for word := range scores {
+    words = append(words, word)
+  }
+
+  sort.Slice(words, func(i, j int) (bool) {
+    return scores[words[i]] > scores[words[j]]
+  })
+
+  if (len(words) > n) {
+    words = words[:n]
+  }
+
+  return words
+}
@@ -0,0 +1,62 @@
+package logos
+
+import (
+  "strings"
+  "testing"
+)
+
+func makePublication(t *testing.T, author string, text string) (Publication) {
+  body, err := CreateStringPubBody(strings.NewReader(text), TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+  return Publication{Score: 0.0, Author: author, Text: body}
+}
+
+func TestCorpusKeyTerms(t *testing.T) {
+  pubs := []Publication{
+    makePublication(t, "alice", "the cat sat on the mat"),
+    makePublication(t, "bob", "the dog sat on the rug"),
+  }
+
+  corpus := CreateCorpus(pubs)
+
+  terms := corpus.KeyTerms(pubs[0], 1)
+  if (len(terms) != 1) {
+    t.Errorf("Expected 1 key term, but got %v", len(terms))
+  }
+
+  if (terms[0] != "cat" && terms[0] != "mat") {
+    t.Errorf("Expected the key term unique to alice's publication, but got %v", terms[0])
+  }
+}
+
+func TestCorpusTermFrequencySameAuthorAndScore(t *testing.T) {
+  pubs := []Publication{
+    makePublication(t, "alice", "apple banana apple"),
+    makePublication(t, "alice", "cherry cherry cherry"),
+  }
+
+  corpus := CreateCorpus(pubs)
+
+  if (corpus.TermFrequency(pubs[1], "cherry") != 1.0) {
+    t.Errorf("Expected pubs[1] to be matched by identity, not conflated with pubs[0]")
+  }
+}
+
+func TestCorpusInverseDocumentFrequency(t *testing.T) {
+  pubs := []Publication{
+    makePublication(t, "alice", "the cat sat on the mat"),
+    makePublication(t, "bob", "the dog sat on the rug"),
+  }
+
+  corpus := CreateCorpus(pubs)
+
+  if (corpus.InverseDocumentFrequency("the") != 0.0) {
+    t.Errorf("Expected a word in every publication to have zero IDF")
+  }
+
+  if (corpus.InverseDocumentFrequency("cat") <= 0.0) {
+    t.Errorf("Expected a word unique to one publication to have positive IDF")
+  }
+}
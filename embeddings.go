@@ -0,0 +1,301 @@
+package logos
+
+import (
+  "math"
+  "math/rand"
+)
+
+/*
+EmbeddingOpts configures Train: how large the learned vectors are, how
+wide the symmetric co-occurrence window is, how many negative samples
+back each positive pair, and how long training runs. Rng may be left
+nil and NegativeSamples left at its zero value; Train fills in a seeded
+default and a floor of 1 respectively, so the zero-value-friendly
+EmbeddingOpts{Dimension: d, Window: w, ...} construction used without
+DefaultEmbeddingOpts() is safe.
+*/
+type EmbeddingOpts struct {
+  Dimension int
+  Window int
+  NegativeSamples int
+  LearningRate float64
+  Epochs int
+  Rng *rand.Rand
+}
+
+// DefaultEmbeddingOpts returns a small, fast-training configuration suitable for modest corpora.
+func DefaultEmbeddingOpts() (EmbeddingOpts) {
+  return EmbeddingOpts{
+    Dimension: 50,
+    Window: 2,
+    NegativeSamples: 5,
+    LearningRate: 0.05,
+    Epochs: 5,
+    Rng: rand.New(rand.NewSource(1)),
+  }
+}
+
+/*
+Model is a trained set of LexVec-style word embeddings: a word matrix W
+and a context matrix C, both indexed by a shared vocabulary.
+*/
+type Model struct {
+  vocabulary []string
+  index map[string]int
+  dimension int
+  W [][]float64
+  C [][]float64
+}
+
+/*
+Train builds word embeddings from a corpus of PublicationBody streams
+using a PPMI-weighted matrix-factorization objective (LexVec-style):
+  1. a first pass streams tokens via NextWord and counts symmetric
+     window co-occurrences M[word][context];
+  2. those counts are converted to shifted-PPMI scores; and
+  3. W and C are trained by SGD, one update per pair with no
+     minibatching, so that W[i]·C[j] approximates
+     the PPMI of (i, j), while being pushed toward zero for k_neg sampled
+     negatives drawn from the unigram^0.75 noise distribution.
+*/
+func Train(corpus []PublicationBody, opts EmbeddingOpts) (*Model) {
+  if (opts.Rng == nil) {
+    opts.Rng = rand.New(rand.NewSource(1))
+  }
+  if (opts.NegativeSamples <= 0) {
+    opts.NegativeSamples = 1
+  }
+
+  cooccurrence, unigramCounts, vocabulary := countCooccurrences(corpus, opts.Window)
+
+  index := make(map[string]int, len(vocabulary))
+  for i, word := range vocabulary {
+    index[word] = i
+  }
+
+  ppmi := computePPMI(cooccurrence, opts.NegativeSamples)
+  noise := buildNoiseDistribution(vocabulary, unigramCounts)
+
+  model := &Model{
+    vocabulary: vocabulary,
+    index: index,
+    dimension: opts.Dimension,
+    W: randomMatrix(len(vocabulary), opts.Dimension, opts.Rng),
+    C: randomMatrix(len(vocabulary), opts.Dimension, opts.Rng),
+  }
+
+  trainSGD(model, ppmi, noise, opts)
+
+  return model
+}
+
+// countCooccurrences streams every PublicationBody once, tallying symmetric window co-occurrences and unigram counts.
+func countCooccurrences(corpus []PublicationBody, window int) (map[string]map[string]float64, map[string]float64, []string) {
+  cooccurrence := make(map[string]map[string]float64)
+  unigramCounts := make(map[string]float64)
+  seen := make(map[string]bool)
+  vocabulary := make([]string, 0)
+
+  for _, body := range corpus {
+    trailing := make([]string, 0, window)
+
+    for (body.HasNextWord()) {
+      w := body.NextWord()
+
+      if (!seen[w]) {
+        seen[w] = true
+        vocabulary = append(vocabulary, w)
+      }
+      unigramCounts[w]++
+
+      for _, ctx := range trailing {
+        addCooccurrence(cooccurrence, w, ctx)
+        addCooccurrence(cooccurrence, ctx, w)
+      }
+
+      trailing = append(trailing, w)
+      if (len(trailing) > window) {
+        trailing = trailing[1:]
+      }
+    }
+  }
+
+  return cooccurrence, unigramCounts, vocabulary
+}
+
+func addCooccurrence(cooccurrence map[string]map[string]float64, i, j string) {
+  row := cooccurrence[i]
+  if (row == nil) {
+    row = make(map[string]float64)
+    cooccurrence[i] = row
+  }
+  row[j]++
+}
+
+/*
+computePPMI converts raw co-occurrence counts into shifted positive
+pointwise mutual information scores:
+  max(0, log((M_ij * total) / (rowSum_i * colSum_j)) - log(k_neg))
+*/
+func computePPMI(cooccurrence map[string]map[string]float64, negativeSamples int) (map[string]map[string]float64) {
+  rowSum := make(map[string]float64)
+  colSum := make(map[string]float64)
+  total := 0.0
+
+  for i, row := range cooccurrence {
+    for j, count := range row {
+      rowSum[i] += count
+      colSum[j] += count
+      total += count
+    }
+  }
+
+  logShift := math.Log(float64(negativeSamples))
+  ppmi := make(map[string]map[string]float64, len(cooccurrence))
+
+  for i, row := range cooccurrence {
+    scored := make(map[string]float64, len(row))
+    for j, count := range row {
+      if (rowSum[i] <= 0.0 || colSum[j] <= 0.0) {
+        continue
+      }
+      pmi := math.Log((count * total) / (rowSum[i] * colSum[j]))
+      scored[j] = math.Max(0.0, pmi-logShift)
+    }
+    ppmi[i] = scored
+  }
+
+  return ppmi
+}
+
+// noiseDistribution samples words for negative sampling, weighted by unigram frequency raised to the 0.75 power.
+type noiseDistribution struct {
+  words []string
+  cumulative []float64
+  total float64
+}
+
+func buildNoiseDistribution(vocabulary []string, unigramCounts map[string]float64) (noiseDistribution) {
+  cumulative := make([]float64, len(vocabulary))
+  total := 0.0
+
+  for i, word := range vocabulary {
+    total += math.Pow(unigramCounts[word], 0.75)
+    cumulative[i] = total
+  }
+
+  return noiseDistribution{words: vocabulary, cumulative: cumulative, total: total}
+}
+
+func (n noiseDistribution) sample(rng *rand.Rand) (string) {
+  if (n.total <= 0.0 || len(n.words) == 0) {
+    return ""
+  }
+
+  draw := rng.Float64() * n.total
+  for i, cumulative := range n.cumulative {
+    if (draw <= cumulative) {
+      return n.words[i]
+    }
+  }
+
+  return n.words[len(n.words)-1]
+}
+
+// randomMatrix allocates a vocabSize x dimension matrix with entries drawn uniformly from [-0.5/d, 0.5/d].
+func randomMatrix(vocabSize, dimension int, rng *rand.Rand) ([][]float64) {
+  bound := 0.5 / float64(dimension)
+  matrix := make([][]float64, vocabSize)
+
+  for i := range matrix {
+    row := make([]float64, dimension)
+    for k := range row {
+      row[k] = (rng.Float64()*2.0 - 1.0) * bound
+    }
+    matrix[i] = row
+  }
+
+  return matrix
+}
+
+/*
+trainSGD fits model.W and model.C so that W[i]·C[j] approximates
+PPMI_ij for every observed pair, and is pushed toward zero for
+opts.NegativeSamples negatives per pair drawn from noise, with the
+learning rate decaying across epochs.
+*/
+func trainSGD(model *Model, ppmi map[string]map[string]float64, noise noiseDistribution, opts EmbeddingOpts) {
+  for epoch := 0; epoch < opts.Epochs; epoch++ {
+    learningRate := opts.LearningRate / float64(epoch+1)
+
+    for i, row := range ppmi {
+      wi := model.index[i]
+
+      for j, target := range row {
+        ci := model.index[j]
+        sgdStep(model.W[wi], model.C[ci], target, learningRate)
+
+        for n := 0; n < opts.NegativeSamples; n++ {
+          neg := noise.sample(opts.Rng)
+          if (neg == "") {
+            continue
+          }
+          sgdStep(model.W[wi], model.C[model.index[neg]], 0.0, learningRate)
+        }
+      }
+    }
+  }
+}
+
+// sgdStep takes one gradient step of (w·c - target)^2 against both w and c.
+func sgdStep(w, c []float64, target, learningRate float64) {
+  dot := 0.0
+  for k := range w {
+    dot += w[k] * c[k]
+  }
+
+  grad := 2.0 * (dot - target)
+
+  for k := range w {
+    wk := w[k]
+    w[k] -= learningRate * grad * c[k]
+    c[k] -= learningRate * grad * wk
+  }
+}
+
+// Vector returns word's learned embedding, or nil if word was never observed during training.
+func (m *Model) Vector(word string) ([]float64) {
+  i, ok := m.index[word]
+  if (!ok) {
+    return nil
+  }
+
+  vector := make([]float64, m.dimension)
+  copy(vector, m.W[i])
+  return vector
+}
+
+/*
+Similarity returns the cosine similarity between a and b's learned
+vectors, or 0 if either word was never observed during training.
+*/
+func (m *Model) Similarity(a, b string) (float64) {
+  va := m.Vector(a)
+  vb := m.Vector(b)
+  if (va == nil || vb == nil) {
+    return 0.0
+  }
+
+  dot, normA, normB := 0.0, 0.0, 0.0
+  for k := range va {
+    dot += va[k] * vb[k]
+    normA += va[k] * va[k]
+    normB += vb[k] * vb[k]
+  }
+
+  if (normA == 0.0 || normB == 0.0) {
+    return 0.0
+  }
+
+  return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
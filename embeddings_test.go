@@ -0,0 +1,68 @@
+package logos
+
+import (
+  "math"
+  "strings"
+  "testing"
+)
+
+func makeBody(t *testing.T, text string) (PublicationBody) {
+  body, err := CreateStringPubBody(strings.NewReader(text), TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+  return body
+}
+
+func TestTrainEmbeddings(t *testing.T) {
+  corpus := []PublicationBody{
+    makeBody(t, "the cat sat on the mat"),
+    makeBody(t, "the dog sat on the rug"),
+  }
+
+  opts := DefaultEmbeddingOpts()
+  opts.Dimension = 4
+  opts.Epochs = 2
+
+  model := Train(corpus, opts)
+
+  vector := model.Vector("cat")
+  if (len(vector) != opts.Dimension) {
+    t.Errorf("Expected a vector of dimension %v, but got %v", opts.Dimension, len(vector))
+  }
+
+  if (model.Vector("nonexistent") != nil) {
+    t.Errorf("Expected nil vector for a word never seen during training")
+  }
+
+  similarity := model.Similarity("cat", "cat")
+  if (similarity < 0.999) {
+    t.Errorf("Expected a word to be maximally similar to itself, but got %v", similarity)
+  }
+
+  if (model.Similarity("cat", "nonexistent") != 0.0) {
+    t.Errorf("Expected zero similarity when a word was never seen during training")
+  }
+}
+
+func TestTrainWithoutDefaultEmbeddingOpts(t *testing.T) {
+  corpus := []PublicationBody{
+    makeBody(t, "the cat sat on the mat"),
+    makeBody(t, "the dog sat on the rug"),
+  }
+
+  opts := EmbeddingOpts{
+    Dimension: 4,
+    Window: 2,
+    LearningRate: 0.05,
+    Epochs: 2,
+  }
+
+  model := Train(corpus, opts)
+
+  for _, v := range model.Vector("cat") {
+    if (math.IsNaN(v) || math.IsInf(v, 0)) {
+      t.Errorf("Expected a finite vector with NegativeSamples left at its zero value, but got %v", v)
+    }
+  }
+}
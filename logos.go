@@ -35,17 +35,17 @@ type StringPublicationBody struct {
   CurrentWord int
 }
 
-func (s StringPublicationBody) HasNextLine() (bool) {
+func (s *StringPublicationBody) HasNextLine() (bool) {
   return len(s.Lines) > s.CurrentLine
 }
 
-func (s StringPublicationBody) NextLine() (string) {
+func (s *StringPublicationBody) NextLine() (string) {
   res := s.Lines[s.CurrentLine]
   s.CurrentLine++
   return res
 }
 
-func (s StringPublicationBody) HasNextWord() (bool) {
+func (s *StringPublicationBody) HasNextWord() (bool) {
   if (len(s.Lines) <= s.CurrentLine) {
     return false
   }
@@ -53,28 +53,30 @@ func (s StringPublicationBody) HasNextWord() (bool) {
   return (len(s.Words[s.CurrentLine]) > s.CurrentWord) || (len(s.Lines) > s.CurrentLine + 1)
 }
 
-func (s StringPublicationBody) NextWord() (string) {
+func (s *StringPublicationBody) NextWord() (string) {
   word := s.Words[s.CurrentLine][s.CurrentWord]
-  if (len(s.Words[s.CurrentLine]) < s.CurrentWord + 1) {
+  if (len(s.Words[s.CurrentLine]) <= s.CurrentWord + 1) {
     s.CurrentLine++
     s.CurrentWord = 0
+  } else {
+    s.CurrentWord++
   }
   return word
 }
 
-func (s StringPublicationBody) ResetSeeker() {
+func (s *StringPublicationBody) ResetSeeker() {
   s.CurrentLine = 0
   s.CurrentWord = 0
 }
 
-func CreateStringPubBody(input io.Reader) (StringPublicationBody, error) {
+func CreateStringPubBody(input io.Reader, opts TokenizerOptions) (*StringPublicationBody, error) {
   scanner := bufio.NewScanner(input)
   lines := make([]string, 0)
   words := make([][]string, 0)
 
   for scanner.Scan() {
     line := scanner.Text()
-    lineWords := splitWords(line)
+    lineWords := splitWords(line, opts)
 
     if (len(lineWords) > 0) {
       lines = append(lines, line)
@@ -82,7 +84,7 @@ func CreateStringPubBody(input io.Reader) (StringPublicationBody, error) {
     }
   }
 
-  body := StringPublicationBody{
+  body := &StringPublicationBody{
     Lines: lines,
     Words: words,
     CurrentLine: 0,
@@ -97,24 +99,31 @@ func CreateStringPubBody(input io.Reader) (StringPublicationBody, error) {
 ---------------------------------------------------------------
 */
 
-func (p PublicationBody) WordCount() (int) {
+func WordCount(p PublicationBody) (int) {
   count := 0
 
   for (p.HasNextWord()) {
-    l := p.NextWord()
+    p.NextWord()
     count++
   }
 
   return count
 }
 
-func (p PublicationBody) AverageWordsPerLine() (float64) {
+/*
+AverageWordsPerLine walks p's lines via HasNextLine/NextLine, so it
+requires a PublicationBody that actually supports line-based access.
+ReaderPublicationBody (and any other implementation that only streams
+words) panics on NextLine; use ComputeAll for a measure that works
+against streaming implementations too.
+*/
+func AverageWordsPerLine(p PublicationBody) (float64) {
   sum := 0
   count := 0
 
   for (p.HasNextLine()) {
     l := p.NextLine()
-    words := splitWords(l)
+    words := splitWords(l, TokenizerOptions{})
     sum += len(words)
     count++
   }
@@ -122,7 +131,7 @@ func (p PublicationBody) AverageWordsPerLine() (float64) {
   return float64(sum) / float64(count)
 }
 
-func (p PublicationBody) AverageWordLength() (float64) {
+func AverageWordLength(p PublicationBody) (float64) {
   sum := 0
   count := 0
 
@@ -135,7 +144,7 @@ func (p PublicationBody) AverageWordLength() (float64) {
   return float64(sum) / float64(count)
 }
 
-func (p PublicationBody) WordsLongerThan(x int) (int) {
+func WordsLongerThan(p PublicationBody, x int) (int) {
   count := 0
 
   for (p.HasNextWord()) {
@@ -148,7 +157,7 @@ func (p PublicationBody) WordsLongerThan(x int) (int) {
   return count
 }
 
-func (p PublicationBody) WordsIn(list WordList) (int) {
+func WordsIn(p PublicationBody, list WordList) (int) {
   count := 0
 
   for (p.HasNextWord()) {
@@ -161,7 +170,40 @@ func (p PublicationBody) WordsIn(list WordList) (int) {
   return count
 }
 
-func (p PublicationBody) ConstructMarkovMatrix(ngramSize int) (MarkovMatrix) {
+/*
+Metrics holds the results of a single pass over a PublicationBody,
+computed by ComputeAll.
+*/
+type Metrics struct {
+  WordCount int
+  TotalWordLength int
+  AverageWordLength float64
+}
+
+/*
+ComputeAll walks p exactly once, computing WordCount and
+AverageWordLength together. Unlike calling WordCount and
+AverageWordLength separately, this works for PublicationBody
+implementations (like ReaderPublicationBody) that stream from an
+io.Reader and cannot support ResetSeeker.
+*/
+func ComputeAll(p PublicationBody) (Metrics) {
+  metrics := Metrics{}
+
+  for (p.HasNextWord()) {
+    w := p.NextWord()
+    metrics.WordCount++
+    metrics.TotalWordLength += len(w)
+  }
+
+  if (metrics.WordCount > 0) {
+    metrics.AverageWordLength = float64(metrics.TotalWordLength) / float64(metrics.WordCount)
+  }
+
+  return metrics
+}
+
+func ConstructMarkovMatrix(p PublicationBody, ngramSize int) (MarkovMatrix) {
   prevNGram := NGram{ngramSize, []string{}}
   matrix := CreateMarkovMatrix()
 
@@ -174,6 +216,9 @@ func (p PublicationBody) ConstructMarkovMatrix(ngramSize int) (MarkovMatrix) {
       curCount := matrix.GetProbability(prevNGram, ngram)
 
       matrix.SetProbability(prevNGram, ngram, curCount + 1.0)
+      prevNGram = ngram
+    } else {
+      prevNGram = NGram{ngramSize, newWords}
     }
   }
 
@@ -185,6 +230,8 @@ func (p PublicationBody) ConstructMarkovMatrix(ngramSize int) (MarkovMatrix) {
       total += matrix.Matrix[i][j]
     }
 
+    res.NGramCounts[i] = total
+
     for j := range matrix.Matrix[i] {
       ig := HashToNGram(i)
       jg := HashToNGram(j)
@@ -212,13 +259,24 @@ func HashToNGram(hash string) (NGram) {
   return NGram{size, s[1:]}
 }
 
+/*
+MarkovMatrix holds row-normalized transition probabilities between
+n-grams. NGramCounts records, for each n-gram with outgoing transitions,
+its raw, pre-normalization transition count (how many times it was
+followed by another n-gram; an occurrence at the very end of the text is
+not counted), so that Seed can weight by source-text frequency
+rather than by Matrix's already-normalized (and therefore nearly
+uniform) row weights.
+*/
 type MarkovMatrix struct {
   Matrix map[string]map[string]float64
+  NGramCounts map[string]float64
 }
 
 func CreateMarkovMatrix() (MarkovMatrix) {
   m := make(map[string]map[string]float64)
-  return MarkovMatrix{m}
+  counts := make(map[string]float64)
+  return MarkovMatrix{m, counts}
 }
 
 func (m MarkovMatrix) SetProbability(i, j NGram, prob float64) {
@@ -258,12 +316,24 @@ func (w WordList) Contains(word string) (bool) {
 }
 
 /*
-splitWords returns the separate words that make up a particular string, making
-sure to remove punctuation and spaces.
+splitWords returns the separate words that make up a particular string,
+making sure to remove punctuation and spaces, then runs each word through
+the normalization pipeline described by opts.
 */
-func splitWords(line string) ([]string) {
+func splitWords(line string, opts TokenizerOptions) ([]string) {
   f := func(c rune) bool {
     return unicode.IsPunct(c) || unicode.IsSpace(c)
   }
-  return strings.FieldsFunc(line, f)
+
+  rawWords := strings.FieldsFunc(line, f)
+  words := make([]string, 0, len(rawWords))
+
+  for _, w := range rawWords {
+    normalized := normalizeWord(w, opts)
+    if (normalized != "") {
+      words = append(words, normalized)
+    }
+  }
+
+  return words
 }
@@ -18,7 +18,7 @@ func TestStringPublicationBody(t *testing.T) {
 
   for _, fixture := range fixtures {
     input := strings.NewReader(fixture.Body)
-    body, err := CreateStringPubBody(input)
+    body, err := CreateStringPubBody(input, TokenizerOptions{})
     if err != nil {
       t.Errorf("Did not expect error: %v", err)
     }
@@ -34,7 +34,7 @@ func TestStringPublicationBody(t *testing.T) {
   }
 }
 
-func countLinesWords(body StringPublicationBody) (int, int) {
+func countLinesWords(body *StringPublicationBody) (int, int) {
   body.ResetSeeker()
   lines := 0
   for (body.HasNextLine()) {
@@ -51,3 +51,16 @@ func countLinesWords(body StringPublicationBody) (int, int) {
 
   return lines, words
 }
+
+func TestComputeAll(t *testing.T) {
+  input := strings.NewReader("This is the body.\nOf the paragraph")
+  body, err := CreateStringPubBody(input, TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  metrics := ComputeAll(body)
+  if (metrics.WordCount != 7) {
+    t.Errorf("Expected 7 words, but got %v", metrics.WordCount)
+  }
+}
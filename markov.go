@@ -0,0 +1,128 @@
+package logos
+
+import (
+  "encoding/gob"
+  "io"
+  "math/rand"
+)
+
+/*
+Seed picks a starting n-gram for a random walk by probability mass,
+weighting each n-gram known to the matrix by how often it actually
+occurred in the source text (NGramCounts). Matrix's own row weights
+can't be used for this: ConstructMarkovMatrix row-normalizes every row
+to sum to ~1.0, so weighting by Matrix alone would make Seed pick
+nearly uniformly regardless of true frequency. If NGramCounts has no
+entry for an n-gram (e.g. a matrix built by hand via SetProbability),
+falls back to that n-gram's row weight in Matrix.
+*/
+func (m MarkovMatrix) Seed(rng *rand.Rand) (NGram) {
+  hashes := make([]string, 0, len(m.Matrix))
+  weights := make([]float64, 0, len(m.Matrix))
+  total := 0.0
+
+  for hash, transitions := range m.Matrix {
+    weight, ok := m.NGramCounts[hash]
+    if (!ok) {
+      for _, prob := range transitions {
+        weight += prob
+      }
+    }
+    hashes = append(hashes, hash)
+    weights = append(weights, weight)
+    total += weight
+  }
+
+  if (total <= 0.0) {
+    return NGram{}
+  }
+
+  draw := rng.Float64() * total
+  cumulative := 0.0
+  for i, weight := range weights {
+    cumulative += weight
+    if (draw <= cumulative) {
+      return HashToNGram(hashes[i])
+    }
+  }
+
+  return HashToNGram(hashes[len(hashes)-1])
+}
+
+/*
+Generate performs a weighted random walk over the transition
+probabilities in m, starting from seed, and returns the sequence of words
+emitted along the way (including the seed's own words). At each step the
+next n-gram is sampled from the distribution in m.Matrix[current.Hash()]
+using cumulative-sum plus a uniform random draw, and the prefix window
+shifts forward by the one new word that n-gram contributes. The walk
+stops once n words have been emitted or a dead-end n-gram (one with no
+outgoing transitions) is reached.
+*/
+func (m MarkovMatrix) Generate(seed NGram, n int, rng *rand.Rand) ([]string) {
+  words := make([]string, len(seed.Words))
+  copy(words, seed.Words)
+  current := seed
+
+  for (len(words) < n) {
+    transitions := m.Matrix[current.Hash()]
+    if (len(transitions) == 0) {
+      break
+    }
+
+    hashes := make([]string, 0, len(transitions))
+    weights := make([]float64, 0, len(transitions))
+    total := 0.0
+
+    for hash, prob := range transitions {
+      hashes = append(hashes, hash)
+      weights = append(weights, prob)
+      total += prob
+    }
+
+    if (total <= 0.0) {
+      break
+    }
+
+    draw := rng.Float64() * total
+    cumulative := 0.0
+    nextHash := hashes[len(hashes)-1]
+
+    for i, weight := range weights {
+      cumulative += weight
+      if (draw <= cumulative) {
+        nextHash = hashes[i]
+        break
+      }
+    }
+
+    next := HashToNGram(nextHash)
+    if (len(next.Words) == 0) {
+      break
+    }
+
+    words = append(words, next.Words[len(next.Words)-1])
+    current = next
+  }
+
+  if (len(words) > n) {
+    words = words[:n]
+  }
+
+  return words
+}
+
+// Save gob-encodes m's transition matrix to w so a trained model can be persisted.
+func (m MarkovMatrix) Save(w io.Writer) (error) {
+  return gob.NewEncoder(w).Encode(m.Matrix)
+}
+
+// Load decodes a transition matrix previously written by Save and installs it into m.
+func (m *MarkovMatrix) Load(r io.Reader) (error) {
+  matrix := make(map[string]map[string]float64)
+  if err := gob.NewDecoder(r).Decode(&matrix); err != nil {
+    return err
+  }
+  m.Matrix = matrix
+  return nil
+}
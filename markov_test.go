@@ -0,0 +1,106 @@
+package logos
+
+import (
+  "bytes"
+  "math/rand"
+  "strings"
+  "testing"
+)
+
+func TestMarkovMatrixGenerate(t *testing.T) {
+  matrix := CreateMarkovMatrix()
+  start := NGram{1, []string{"the"}}
+  next := NGram{1, []string{"cat"}}
+  matrix.SetProbability(start, next, 1.0)
+  matrix.SetProbability(next, start, 1.0)
+
+  rng := rand.New(rand.NewSource(1))
+  words := matrix.Generate(start, 4, rng)
+
+  if (len(words) != 4) {
+    t.Errorf("Expected 4 words, but got %v", len(words))
+  }
+
+  if (words[0] != "the") {
+    t.Errorf("Expected walk to start with the seed word, but got %v", words[0])
+  }
+}
+
+func TestConstructMarkovMatrixGenerate(t *testing.T) {
+  input := strings.NewReader("the cat sat on the mat the cat sat")
+  body, err := CreateStringPubBody(input, TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  matrix := ConstructMarkovMatrix(body, 1)
+  if (len(matrix.Matrix) == 0) {
+    t.Errorf("Expected training from a PublicationBody to produce transitions, but matrix was empty")
+  }
+
+  rng := rand.New(rand.NewSource(1))
+  seed := matrix.Seed(rng)
+  words := matrix.Generate(seed, 5, rng)
+
+  if (len(words) != 5) {
+    t.Errorf("Expected 5 words, but got %v", len(words))
+  }
+}
+
+func TestConstructMarkovMatrixNGramCounts(t *testing.T) {
+  input := strings.NewReader("the cat the dog the bird")
+  body, err := CreateStringPubBody(input, TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  matrix := ConstructMarkovMatrix(body, 1)
+  theHash := NGram{1, []string{"the"}}.Hash()
+
+  if (matrix.NGramCounts[theHash] != 3.0) {
+    t.Errorf("Expected \"the\" to have occurred 3 times, but got %v", matrix.NGramCounts[theHash])
+  }
+}
+
+func TestSeedWeightsByNGramCounts(t *testing.T) {
+  matrix := CreateMarkovMatrix()
+  frequent := NGram{1, []string{"the"}}
+  rare := NGram{1, []string{"zebra"}}
+  matrix.SetProbability(frequent, rare, 1.0)
+  matrix.SetProbability(rare, frequent, 1.0)
+  matrix.NGramCounts[frequent.Hash()] = 99.0
+  matrix.NGramCounts[rare.Hash()] = 1.0
+
+  rng := rand.New(rand.NewSource(1))
+  frequentPicks := 0
+  for i := 0; i < 20; i++ {
+    if (matrix.Seed(rng).Hash() == frequent.Hash()) {
+      frequentPicks++
+    }
+  }
+
+  if (frequentPicks <= 10) {
+    t.Errorf("Expected Seed to favor the n-gram with the higher NGramCounts weight")
+  }
+}
+
+func TestMarkovMatrixSaveLoad(t *testing.T) {
+  matrix := CreateMarkovMatrix()
+  i := NGram{1, []string{"the"}}
+  j := NGram{1, []string{"cat"}}
+  matrix.SetProbability(i, j, 0.5)
+
+  var buf bytes.Buffer
+  if err := matrix.Save(&buf); err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  loaded := CreateMarkovMatrix()
+  if err := loaded.Load(&buf); err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  if (loaded.GetProbability(i, j) != 0.5) {
+    t.Errorf("Expected loaded probability 0.5, but got %v", loaded.GetProbability(i, j))
+  }
+}
@@ -0,0 +1,110 @@
+package logos
+
+import (
+  "bufio"
+  "io"
+  "unicode"
+  "unicode/utf8"
+)
+
+/*
+ReaderPublicationBody streams words out of an io.Reader using a
+bufio.Scanner in word-split mode, so large articles can be analyzed
+without loading the whole text into memory. Because it only ever reads
+forward over the underlying reader, it has no notion of lines and cannot
+rewind: HasNextLine, NextLine and ResetSeeker all panic. Measures that
+need a single forward pass, like ComputeAll, work fine against it.
+*/
+type ReaderPublicationBody struct {
+  scanner *bufio.Scanner
+  opts TokenizerOptions
+  next string
+  hasNext bool
+}
+
+func CreateReaderPubBody(input io.Reader, opts TokenizerOptions) (*ReaderPublicationBody) {
+  body := &ReaderPublicationBody{
+    scanner: bufio.NewScanner(input),
+    opts: opts,
+  }
+  body.scanner.Split(scanWordsAndPunct)
+  body.advance()
+  return body
+}
+
+/*
+scanWordsAndPunct is a bufio.SplitFunc that treats both whitespace and
+punctuation as token boundaries, mirroring the strings.FieldsFunc
+predicate splitWords uses for StringPublicationBody. Without this,
+ReaderPublicationBody (which relies on MetaChars-only stripping in
+normalizeWord) would keep punctuation attached to the word it borders,
+e.g. splitting "dog." as "dog." rather than "dog". Adapted from the
+standard library's bufio.ScanWords.
+*/
+func scanWordsAndPunct(data []byte, atEOF bool) (advance int, token []byte, err error) {
+  isBoundary := func(r rune) (bool) {
+    return unicode.IsSpace(r) || unicode.IsPunct(r)
+  }
+
+  start := 0
+  for width := 0; start < len(data); start += width {
+    var r rune
+    r, width = utf8.DecodeRune(data[start:])
+    if (!isBoundary(r)) {
+      break
+    }
+  }
+
+  for width, i := 0, start; i < len(data); i += width {
+    var r rune
+    r, width = utf8.DecodeRune(data[i:])
+    if (isBoundary(r)) {
+      return i + width, data[start:i], nil
+    }
+  }
+
+  if (atEOF && len(data) > start) {
+    return len(data), data[start:], nil
+  }
+
+  if (atEOF) {
+    return len(data), nil, nil
+  }
+
+  return start, nil, nil
+}
+
+// advance pulls the next normalized, non-empty token off the scanner, if any.
+func (r *ReaderPublicationBody) advance() {
+  for r.scanner.Scan() {
+    word := normalizeWord(r.scanner.Text(), r.opts)
+    if (word != "") {
+      r.next = word
+      r.hasNext = true
+      return
+    }
+  }
+  r.hasNext = false
+}
+
+func (r *ReaderPublicationBody) HasNextWord() (bool) {
+  return r.hasNext
+}
+
+func (r *ReaderPublicationBody) NextWord() (string) {
+  word := r.next
+  r.advance()
+  return word
+}
+
+func (r *ReaderPublicationBody) HasNextLine() (bool) {
+  panic("ReaderPublicationBody streams words only; line-based access is not supported")
+}
+
+func (r *ReaderPublicationBody) NextLine() (string) {
+  panic("ReaderPublicationBody streams words only; line-based access is not supported")
+}
+
+func (r *ReaderPublicationBody) ResetSeeker() {
+  panic("ReaderPublicationBody cannot rewind a streaming io.Reader; use ComputeAll for a single-pass measure")
+}
@@ -0,0 +1,47 @@
+package logos
+
+import (
+  "strings"
+  "testing"
+)
+
+func TestReaderPublicationBody(t *testing.T) {
+  input := strings.NewReader("This is the body.\nOf the paragraph")
+  body := CreateReaderPubBody(input, TokenizerOptions{})
+
+  words := 0
+  for (body.HasNextWord()) {
+    body.NextWord()
+    words++
+  }
+
+  if (words != 7) {
+    t.Errorf("Expected 7 words, but got %v", words)
+  }
+}
+
+func TestReaderPublicationBodyStripsPunctuation(t *testing.T) {
+  input := strings.NewReader("The dog. The cat!")
+  body := CreateReaderPubBody(input, TokenizerOptions{})
+
+  words := make([]string, 0)
+  for (body.HasNextWord()) {
+    words = append(words, body.NextWord())
+  }
+
+  for _, w := range words {
+    if (w != "The" && w != "dog" && w != "cat") {
+      t.Errorf("Expected punctuation to be stripped, but got %v", w)
+    }
+  }
+}
+
+func TestReaderPublicationBodyComputeAll(t *testing.T) {
+  input := strings.NewReader("one two three four")
+  body := CreateReaderPubBody(input, TokenizerOptions{})
+
+  metrics := ComputeAll(body)
+  if (metrics.WordCount != 4) {
+    t.Errorf("Expected 4 words, but got %v", metrics.WordCount)
+  }
+}
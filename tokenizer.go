@@ -0,0 +1,315 @@
+package logos
+
+import (
+  "strings"
+)
+
+/*
+TokenizerOptions configures the normalization pipeline that raw line text
+is passed through before it is surfaced as a word by a PublicationBody.
+Lowercasing, stopword removal and stemming are all optional so callers can
+compare publications at whatever level of normalization makes sense for
+the measure they're computing.
+*/
+type TokenizerOptions struct {
+  Lowercase bool
+  RemoveStopwords *WordList
+  Stem bool
+  MetaChars []rune
+}
+
+/*
+EnglishStopwords is a small default WordList of common English stopwords,
+suitable for passing as TokenizerOptions.RemoveStopwords.
+*/
+func EnglishStopwords() (WordList) {
+  return ConstructWordList([]string{
+    "a", "an", "and", "are", "as", "at", "be", "but", "by",
+    "for", "if", "in", "into", "is", "it", "no", "not", "of",
+    "on", "or", "such", "that", "the", "their", "then", "there",
+    "these", "they", "this", "to", "was", "will", "with",
+  })
+}
+
+/*
+normalizeWord runs a single token through opts, returning the empty
+string if the word should be dropped entirely (e.g. it is a stopword or
+becomes empty once metacharacters are stripped).
+*/
+func normalizeWord(word string, opts TokenizerOptions) (string) {
+  for _, c := range opts.MetaChars {
+    word = strings.Replace(word, string(c), "", -1)
+  }
+
+  if (opts.Lowercase) {
+    word = strings.ToLower(word)
+  }
+
+  if (word == "") {
+    return ""
+  }
+
+  if (opts.RemoveStopwords != nil && opts.RemoveStopwords.Contains(word)) {
+    return ""
+  }
+
+  if (opts.Stem) {
+    word = PorterStem(word)
+  }
+
+  return word
+}
+
+/*
+isVowel reports whether the byte at index i of word is a vowel. 'y' is a
+vowel only when it is preceded by a consonant; at the start of a word (or
+anywhere preceded by a vowel) it counts as a consonant, per Porter's 1980
+algorithm.
+*/
+func isVowel(word string, i int) (bool) {
+  switch word[i] {
+  case 'a', 'e', 'i', 'o', 'u':
+    return true
+  case 'y':
+    return i != 0 && !isVowel(word, i-1)
+  default:
+    return false
+  }
+}
+
+/*
+measure computes the Porter "m" value of stem: a word is represented as
+[C](VC)^m[V], where C is a (possibly empty) run of consonants and V a
+(possibly empty) run of vowels, and m counts the number of times a run of
+vowels is followed by a run of consonants.
+*/
+func measure(stem string) (int) {
+  m := 0
+  prevVowel := false
+
+  for i := 0; i < len(stem); i++ {
+    v := isVowel(stem, i)
+    if (!v && prevVowel) {
+      m++
+    }
+    prevVowel = v
+  }
+
+  return m
+}
+
+// containsVowel reports whether stem contains at least one vowel.
+func containsVowel(stem string) (bool) {
+  for i := 0; i < len(stem); i++ {
+    if (isVowel(stem, i)) {
+      return true
+    }
+  }
+  return false
+}
+
+// endsWithDoubleConsonant reports whether stem ends in a double consonant, e.g. "tt", "ss".
+func endsWithDoubleConsonant(stem string) (bool) {
+  n := len(stem)
+  if (n < 2) {
+    return false
+  }
+  return stem[n-1] == stem[n-2] && !isVowel(stem, n-1)
+}
+
+/*
+endsCVC reports whether stem ends in consonant-vowel-consonant, where the
+final consonant is not w, x or y (the *o condition from Porter's paper).
+*/
+func endsCVC(stem string) (bool) {
+  n := len(stem)
+  if (n < 3) {
+    return false
+  }
+  if (isVowel(stem, n-3) || !isVowel(stem, n-2) || isVowel(stem, n-1)) {
+    return false
+  }
+  switch stem[n-1] {
+  case 'w', 'x', 'y':
+    return false
+  default:
+    return true
+  }
+}
+
+func hasSuffix(word, suffix string) (bool) {
+  return len(word) >= len(suffix) && word[len(word)-len(suffix):] == suffix
+}
+
+func trimSuffix(word, suffix string) (string) {
+  return word[:len(word)-len(suffix)]
+}
+
+/*
+replaceSuffixIfMeasure replaces suffix with replacement when word ends in
+suffix and the measure of the resulting stem satisfies minMeasure (m > 0
+is expressed as minMeasure == 1). Returns the (possibly unchanged) word
+and whether a replacement was made.
+*/
+func replaceSuffixIfMeasure(word, suffix, replacement string, minMeasure int) (string, bool) {
+  if (!hasSuffix(word, suffix)) {
+    return word, false
+  }
+  stem := trimSuffix(word, suffix)
+  if (measure(stem) >= minMeasure) {
+    return stem + replacement, true
+  }
+  return word, false
+}
+
+/*
+PorterStem reduces word to its word stem using the Porter stemming
+algorithm (steps 1a, 1b, 1c, 2, 3, 4, 5a and 5b over suffix rules
+operating on the measure m of the stem, as described in Porter's 1980
+paper "An algorithm for suffix stripping").
+*/
+func PorterStem(word string) (string) {
+  if (len(word) <= 2) {
+    return word
+  }
+
+  word = step1a(word)
+  word = step1b(word)
+  word = step1c(word)
+  word = step2(word)
+  word = step3(word)
+  word = step4(word)
+  word = step5a(word)
+  word = step5b(word)
+
+  return word
+}
+
+func step1a(word string) (string) {
+  switch {
+  case hasSuffix(word, "sses"):
+    return trimSuffix(word, "sses") + "ss"
+  case hasSuffix(word, "ies"):
+    return trimSuffix(word, "ies") + "i"
+  case hasSuffix(word, "ss"):
+    return word
+  case hasSuffix(word, "s"):
+    return trimSuffix(word, "s")
+  }
+  return word
+}
+
+func step1b(word string) (string) {
+  var stem string
+  matched := false
+
+  if (hasSuffix(word, "eed")) {
+    stem = trimSuffix(word, "eed")
+    if (measure(stem) > 0) {
+      return stem + "ee"
+    }
+    return word
+  } else if (hasSuffix(word, "ed")) {
+    stem = trimSuffix(word, "ed")
+    matched = containsVowel(stem)
+  } else if (hasSuffix(word, "ing")) {
+    stem = trimSuffix(word, "ing")
+    matched = containsVowel(stem)
+  }
+
+  if (!matched) {
+    return word
+  }
+
+  switch {
+  case hasSuffix(stem, "at"), hasSuffix(stem, "bl"), hasSuffix(stem, "iz"):
+    return stem + "e"
+  case endsWithDoubleConsonant(stem) && !hasSuffix(stem, "l") && !hasSuffix(stem, "s") && !hasSuffix(stem, "z"):
+    return stem[:len(stem)-1]
+  case measure(stem) == 1 && endsCVC(stem):
+    return stem + "e"
+  }
+
+  return stem
+}
+
+func step1c(word string) (string) {
+  if (hasSuffix(word, "y") && containsVowel(trimSuffix(word, "y"))) {
+    return trimSuffix(word, "y") + "i"
+  }
+  return word
+}
+
+var step2Suffixes = [][2]string{
+  {"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"},
+  {"izer", "ize"}, {"abli", "able"}, {"alli", "al"}, {"entli", "ent"},
+  {"eli", "e"}, {"ousli", "ous"}, {"ization", "ize"}, {"ation", "ate"},
+  {"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"},
+  {"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+}
+
+func step2(word string) (string) {
+  for _, rule := range step2Suffixes {
+    if (hasSuffix(word, rule[0])) {
+      result, _ := replaceSuffixIfMeasure(word, rule[0], rule[1], 1)
+      return result
+    }
+  }
+  return word
+}
+
+var step3Suffixes = [][2]string{
+  {"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"},
+  {"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+func step3(word string) (string) {
+  for _, rule := range step3Suffixes {
+    if (hasSuffix(word, rule[0])) {
+      result, _ := replaceSuffixIfMeasure(word, rule[0], rule[1], 1)
+      return result
+    }
+  }
+  return word
+}
+
+var step4Suffixes = []string{
+  "al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement",
+  "ment", "ent", "ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+func step4(word string) (string) {
+  for _, suffix := range step4Suffixes {
+    if (!hasSuffix(word, suffix)) {
+      continue
+    }
+    stem := trimSuffix(word, suffix)
+    if (suffix == "ion" && !(hasSuffix(stem, "s") || hasSuffix(stem, "t"))) {
+      return word
+    }
+    if (measure(stem) > 1) {
+      return stem
+    }
+    return word
+  }
+  return word
+}
+
+func step5a(word string) (string) {
+  if (!hasSuffix(word, "e")) {
+    return word
+  }
+  stem := trimSuffix(word, "e")
+  m := measure(stem)
+  if (m > 1 || (m == 1 && !endsCVC(stem))) {
+    return stem
+  }
+  return word
+}
+
+func step5b(word string) (string) {
+  if (hasSuffix(word, "ll") && measure(trimSuffix(word, "l")) > 1) {
+    return trimSuffix(word, "l")
+  }
+  return word
+}
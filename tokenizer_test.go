@@ -0,0 +1,55 @@
+package logos
+
+import (
+  "testing"
+)
+
+func TestPorterStem(t *testing.T) {
+  fixtures := []struct {
+    Word string
+    Stem string
+  }{
+    {"caresses", "caress"},
+    {"ponies", "poni"},
+    {"agreed", "agre"},
+    {"feed", "feed"},
+    {"plastered", "plaster"},
+    {"relational", "relat"},
+    {"conformably", "conform"},
+  }
+
+  for _, fixture := range fixtures {
+    stem := PorterStem(fixture.Word)
+    if (stem != fixture.Stem) {
+      t.Errorf("Expected %v to stem to %v, but got %v", fixture.Word, fixture.Stem, stem)
+    }
+  }
+}
+
+func TestIsVowelTreatsLeadingYAsConsonant(t *testing.T) {
+  if (isVowel("yellow", 0)) {
+    t.Errorf("Expected leading y to be treated as a consonant")
+  }
+
+  if (!isVowel("happy", 4)) {
+    t.Errorf("Expected y preceded by a consonant to be treated as a vowel")
+  }
+}
+
+func TestNormalizeWord(t *testing.T) {
+  stopwords := EnglishStopwords()
+  opts := TokenizerOptions{
+    Lowercase: true,
+    RemoveStopwords: &stopwords,
+    Stem: true,
+  }
+
+  if (normalizeWord("The", opts) != "") {
+    t.Errorf("Expected stopword to be removed")
+  }
+
+  normalized := normalizeWord("Ponies", opts)
+  if (normalized != "poni") {
+    t.Errorf("Expected Ponies to normalize to poni, but got %v", normalized)
+  }
+}
@@ -0,0 +1,98 @@
+package logos
+
+import (
+  "container/heap"
+  "sort"
+)
+
+/*
+WordFrequency pairs a word with the number of times it occurred, as
+produced by TopKWords.
+*/
+type WordFrequency struct {
+  Word string
+  Count int
+}
+
+type wordFrequencyHeap []WordFrequency
+
+func (h wordFrequencyHeap) Len() (int) { return len(h) }
+func (h wordFrequencyHeap) Less(i, j int) (bool) { return h[i].Count < h[j].Count }
+func (h wordFrequencyHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *wordFrequencyHeap) Push(x interface{}) {
+  *h = append(*h, x.(WordFrequency))
+}
+
+func (h *wordFrequencyHeap) Pop() (interface{}) {
+  old := *h
+  n := len(old)
+  item := old[n-1]
+  *h = old[:n-1]
+  return item
+}
+
+/*
+TopK is a reusable streaming top-k word counter: repeated calls to Add
+accumulate each word's occurrence count internally, and Result selects
+the k highest counts seen so far using a min-heap of size k, so the
+selection heap's memory stays O(k) regardless of how many distinct words
+were added.
+*/
+type TopK struct {
+  k int
+  counts map[string]int
+}
+
+func CreateTopK(k int) (TopK) {
+  return TopK{k: k, counts: make(map[string]int)}
+}
+
+// Add records one more occurrence of word.
+func (t *TopK) Add(word string) {
+  t.counts[word]++
+}
+
+/*
+Result returns the k words with the highest counts accumulated so far
+via Add, ordered from most to least frequent.
+*/
+func (t *TopK) Result() ([]WordFrequency) {
+  items := make(wordFrequencyHeap, 0, t.k)
+  heap.Init(&items)
+
+  for word, count := range t.counts {
+    heap.Push(&items, WordFrequency{Word: word, Count: count})
+    if (items.Len() > t.k) {
+      heap.Pop(&items)
+    }
+  }
+
+  result := make([]WordFrequency, len(items))
+  copy(result, items)
+
+  sort.Slice(result, func(i, j int) (bool) {
+    return result[i].Count > result[j].Count
+  })
+
+  return result
+}
+
+/*
+TopKWords scans p and returns the k most frequent words it contains. If
+filter is non-nil, only words present in it are counted; if filter is
+nil, every token is counted.
+*/
+func TopKWords(p PublicationBody, k int, filter *WordList) ([]WordFrequency) {
+  topK := CreateTopK(k)
+
+  for (p.HasNextWord()) {
+    w := p.NextWord()
+    if (filter != nil && !filter.Contains(w)) {
+      continue
+    }
+    topK.Add(w)
+  }
+
+  return topK.Result()
+}
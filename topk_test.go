@@ -0,0 +1,64 @@
+package logos
+
+import (
+  "strings"
+  "testing"
+)
+
+func TestTopKWords(t *testing.T) {
+  input := strings.NewReader("the cat sat on the mat the cat looked at the cat")
+  body, err := CreateStringPubBody(input, TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  result := TopKWords(body, 2, nil)
+  if (len(result) != 2) {
+    t.Errorf("Expected 2 results, but got %v", len(result))
+  }
+
+  if (result[0].Word != "the" || result[0].Count != 4) {
+    t.Errorf("Expected most frequent word to be \"the\" with count 4, but got %+v", result[0])
+  }
+}
+
+func TestTopKAddAccumulatesRepeatedCalls(t *testing.T) {
+  topK := CreateTopK(2)
+  topK.Add("cat")
+  topK.Add("dog")
+  topK.Add("cat")
+  topK.Add("cat")
+  topK.Add("dog")
+
+  result := topK.Result()
+  if (len(result) != 2) {
+    t.Errorf("Expected 2 results, but got %v", len(result))
+  }
+
+  if (result[0].Word != "cat" || result[0].Count != 3) {
+    t.Errorf("Expected \"cat\" with count 3 from repeated Add calls, but got %+v", result[0])
+  }
+
+  if (result[1].Word != "dog" || result[1].Count != 2) {
+    t.Errorf("Expected \"dog\" with count 2 from repeated Add calls, but got %+v", result[1])
+  }
+}
+
+func TestTopKWordsWithFilter(t *testing.T) {
+  input := strings.NewReader("the cat sat on the mat the cat looked at the cat")
+  body, err := CreateStringPubBody(input, TokenizerOptions{})
+  if err != nil {
+    t.Errorf("Did not expect error: %v", err)
+  }
+
+  filter := ConstructWordList([]string{"cat"})
+  result := TopKWords(body, 5, &filter)
+
+  if (len(result) != 1) {
+    t.Errorf("Expected 1 result, but got %v", len(result))
+  }
+
+  if (result[0].Word != "cat" || result[0].Count != 3) {
+    t.Errorf("Expected \"cat\" with count 3, but got %+v", result[0])
+  }
+}